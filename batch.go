@@ -0,0 +1,205 @@
+package skillsclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// defaultBatchConcurrency is used when Client.BatchConcurrency is left unset.
+const defaultBatchConcurrency = 5
+
+// BatchItemResult is the outcome of a single item within a batch request.
+type BatchItemResult struct {
+	Index   int
+	Success bool
+	Reason  string
+}
+
+// BatchResult is the outcome of a batch request, one BatchItemResult per input
+// item in the same order they were submitted.
+type BatchResult struct {
+	Results []BatchItemResult
+}
+
+// AllSucceeded reports whether every item in the batch succeeded.
+func (r BatchResult) AllSucceeded() bool {
+	for _, item := range r.Results {
+		if !item.Success {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *Client) batchConcurrency() int {
+	if c.BatchConcurrency > 0 {
+		return c.BatchConcurrency
+	}
+	return defaultBatchConcurrency
+}
+
+// isBatchEndpointMissing reports whether the server doesn't implement the
+// batch path at all, meaning callers should fall back to fanning out to the
+// per-item endpoint.
+func isBatchEndpointMissing(resp *http.Response) bool {
+	return resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusMethodNotAllowed
+}
+
+// AssociateSkillsWithProjects associates many skill/project pairs in one call.
+// If the server doesn't implement the batch endpoint (404/405), it
+// transparently falls back to calling AssociateSkillWithProject for each pair
+// with up to Client.BatchConcurrency requests in flight at once.
+func (c *Client) AssociateSkillsWithProjects(ctx context.Context, sps []SkillProject, opts ...RequestOption) (BatchResult, error) {
+	body, err := json.Marshal(sps)
+	if err != nil {
+		return BatchResult{}, err
+	}
+
+	resp, bodyBytes, _, err := c.doRequest(ctx, http.MethodPost, "/skills/associate/batch", nil, bytes.NewBuffer(body), opts...)
+	if err != nil {
+		return BatchResult{}, err
+	}
+
+	if isBatchEndpointMissing(resp) {
+		return runBatch(len(sps), c.batchConcurrency(), func(i int) error {
+			return c.AssociateSkillWithProject(ctx, sps[i], opts...)
+		}), nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return BatchResult{}, newAPIError(resp, bodyBytes)
+	}
+
+	var result BatchResult
+	if err := json.Unmarshal(bodyBytes, &result); err != nil {
+		return BatchResult{}, err
+	}
+
+	return result, nil
+}
+
+// DisassociateSkillsFromProjects removes many skill/project associations in
+// one call, falling back to per-item requests like AssociateSkillsWithProjects
+// when the server doesn't implement the batch endpoint.
+func (c *Client) DisassociateSkillsFromProjects(ctx context.Context, sps []SkillProject, opts ...RequestOption) (BatchResult, error) {
+	body, err := json.Marshal(sps)
+	if err != nil {
+		return BatchResult{}, err
+	}
+
+	resp, bodyBytes, _, err := c.doRequest(ctx, http.MethodPost, "/skills/disassociate/batch", nil, bytes.NewBuffer(body), opts...)
+	if err != nil {
+		return BatchResult{}, err
+	}
+
+	if isBatchEndpointMissing(resp) {
+		return runBatch(len(sps), c.batchConcurrency(), func(i int) error {
+			return c.DisassociateSkillFromProject(ctx, sps[i], opts...)
+		}), nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return BatchResult{}, newAPIError(resp, bodyBytes)
+	}
+
+	var result BatchResult
+	if err := json.Unmarshal(bodyBytes, &result); err != nil {
+		return BatchResult{}, err
+	}
+
+	return result, nil
+}
+
+// BulkCreateSkills creates many skills in one call, falling back to
+// CreateSkill per item when the server doesn't implement the batch endpoint.
+func (c *Client) BulkCreateSkills(ctx context.Context, skills []Skill, opts ...RequestOption) (BatchResult, error) {
+	body, err := json.Marshal(skills)
+	if err != nil {
+		return BatchResult{}, err
+	}
+
+	resp, bodyBytes, _, err := c.doRequest(ctx, http.MethodPost, "/skills/batch", nil, bytes.NewBuffer(body), opts...)
+	if err != nil {
+		return BatchResult{}, err
+	}
+
+	if isBatchEndpointMissing(resp) {
+		return runBatch(len(skills), c.batchConcurrency(), func(i int) error {
+			_, err := c.CreateSkill(ctx, &skills[i], opts...)
+			return err
+		}), nil
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return BatchResult{}, newAPIError(resp, bodyBytes)
+	}
+
+	var result BatchResult
+	if err := json.Unmarshal(bodyBytes, &result); err != nil {
+		return BatchResult{}, err
+	}
+
+	return result, nil
+}
+
+// BulkDeleteSkills deletes many skills by ID in one call, falling back to
+// DeleteSkill per item when the server doesn't implement the batch endpoint.
+func (c *Client) BulkDeleteSkills(ctx context.Context, skillIDs []uuid.UUID, opts ...RequestOption) (BatchResult, error) {
+	body, err := json.Marshal(skillIDs)
+	if err != nil {
+		return BatchResult{}, err
+	}
+
+	resp, bodyBytes, _, err := c.doRequest(ctx, http.MethodDelete, "/skills/batch", nil, bytes.NewBuffer(body), opts...)
+	if err != nil {
+		return BatchResult{}, err
+	}
+
+	if isBatchEndpointMissing(resp) {
+		return runBatch(len(skillIDs), c.batchConcurrency(), func(i int) error {
+			return c.DeleteSkill(ctx, skillIDs[i], opts...)
+		}), nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return BatchResult{}, newAPIError(resp, bodyBytes)
+	}
+
+	var result BatchResult
+	if err := json.Unmarshal(bodyBytes, &result); err != nil {
+		return BatchResult{}, err
+	}
+
+	return result, nil
+}
+
+// runBatch calls do(i) for each i in [0,n) with at most concurrency calls in
+// flight at once, collecting each call's outcome into a BatchResult in order.
+func runBatch(n, concurrency int, do func(i int) error) BatchResult {
+	results := make([]BatchItemResult, n)
+	sem := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := do(i); err != nil {
+				results[i] = BatchItemResult{Index: i, Reason: err.Error()}
+				return
+			}
+			results[i] = BatchItemResult{Index: i, Success: true}
+		}(i)
+	}
+	wg.Wait()
+
+	return BatchResult{Results: results}
+}
@@ -3,13 +3,15 @@ package skillsclient
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -21,6 +23,64 @@ type Client struct {
 	HttpClient *http.Client
 	Token      string
 	ApiKey     string
+
+	// Cache stores ETags and response bodies for GET requests so that a
+	// subsequent request which the server answers with 304 Not Modified can be
+	// satisfied from memory instead of re-fetching the body. Set to nil to
+	// disable caching.
+	Cache Cache
+
+	// BatchConcurrency caps how many requests the bulk/batch helpers (see
+	// batch.go) fan out at once when the server doesn't support a true batch
+	// endpoint. Defaults to defaultBatchConcurrency when left at 0.
+	BatchConcurrency int
+
+	rateLimitMu sync.Mutex
+	rateLimit   RateLimit
+}
+
+// SkillWithETag pairs a Skill with the ETag the server returned alongside it,
+// so callers can make a future conditional request via GetSkillByIDIfNoneMatch.
+type SkillWithETag struct {
+	Skill Skill
+	ETag  string
+}
+
+// CacheEntry is a single cached GET response, keyed by request URL in a Cache.
+type CacheEntry struct {
+	ETag string
+	Body []byte
+}
+
+// Cache is implemented by anything that can store and retrieve CacheEntry
+// values by request URL. MemoryCache is the default implementation.
+type Cache interface {
+	Get(key string) (CacheEntry, bool)
+	Set(key string, entry CacheEntry)
+}
+
+// MemoryCache is an in-memory Cache safe for concurrent use.
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]CacheEntry
+}
+
+// NewMemoryCache returns an empty, ready-to-use MemoryCache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: make(map[string]CacheEntry)}
+}
+
+func (c *MemoryCache) Get(key string) (CacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	return entry, ok
+}
+
+func (c *MemoryCache) Set(key string, entry CacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = entry
 }
 
 // Skill represents the structure of a skill.
@@ -37,145 +97,507 @@ type SkillProject struct {
 	ProjectID uuid.UUID `json:"project_id"`
 }
 
+// APIError represents an error returned by the skills server, parsed from the
+// response body and headers rather than treating the raw body as an opaque string.
+type APIError struct {
+	StatusCode int
+	Message    string
+	Code       string
+	RequestID  string
+}
+
+func (e *APIError) Error() string {
+	if e.RequestID != "" {
+		return fmt.Sprintf("skillsclient: %s (status %d, code %q, request id %s)", e.Message, e.StatusCode, e.Code, e.RequestID)
+	}
+	return fmt.Sprintf("skillsclient: %s (status %d, code %q)", e.Message, e.StatusCode, e.Code)
+}
+
+// apiErrorBody is the shape of the JSON error body the server is expected to return.
+type apiErrorBody struct {
+	Message string `json:"message"`
+	Code    string `json:"code"`
+}
+
+// newAPIError builds an APIError from a non-2xx response and its already-read
+// body (doRequest has read and closed resp.Body by the time callers see it,
+// so it must be passed in rather than re-read from the response).
+func newAPIError(resp *http.Response, bodyBytes []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		RequestID:  resp.Header.Get("X-Request-ID"),
+	}
+
+	var parsed apiErrorBody
+	if err := json.Unmarshal(bodyBytes, &parsed); err != nil || parsed.Message == "" {
+		apiErr.Message = string(bodyBytes)
+		if apiErr.Message == "" {
+			apiErr.Message = resp.Status
+		}
+		return apiErr
+	}
+
+	apiErr.Message = parsed.Message
+	apiErr.Code = parsed.Code
+	return apiErr
+}
+
+// RequestOption customizes a single request made by Client.
+type RequestOption func(*requestConfig)
+
+type requestConfig struct {
+	header  http.Header
+	query   url.Values
+	timeout time.Duration
+}
+
+// WithHeader sets an additional header on the request.
+func WithHeader(key, value string) RequestOption {
+	return func(cfg *requestConfig) {
+		cfg.header.Set(key, value)
+	}
+}
+
+// WithQuery sets an additional query parameter on the request.
+func WithQuery(key, value string) RequestOption {
+	return func(cfg *requestConfig) {
+		cfg.query.Set(key, value)
+	}
+}
+
+// WithTimeout overrides the client's default timeout for a single request.
+func WithTimeout(d time.Duration) RequestOption {
+	return func(cfg *requestConfig) {
+		cfg.timeout = d
+	}
+}
+
 func NewClient(baseURL string, token string, apiKey string, httpClient ...*http.Client) *Client {
 	var client *http.Client
 	if len(httpClient) > 0 {
-		client = httpClient[0]
+		// Copy rather than mutate the caller's *http.Client: it may be shared
+		// with other code, and wrapping its Transport in place would silently
+		// route that other traffic through our retry/circuit-breaker logic too.
+		clientCopy := *httpClient[0]
+		client = &clientCopy
 	} else {
 		client = &http.Client{
 			Timeout: time.Second * 10,
 		}
 	}
 
-	return &Client{
+	c := &Client{
 		BaseURL:    baseURL,
 		HttpClient: client,
 		Token:      token,
 		ApiKey:     apiKey,
+		Cache:      NewMemoryCache(),
+	}
+
+	next := client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	client.Transport = &retryTransport{
+		next:    next,
+		client:  c,
+		retries: defaultMaxRetries,
+		breaker: newCircuitBreaker(defaultBreakerThreshold, defaultBreakerCooldown),
 	}
+
+	return c
 }
 
-// CreateSkill sends a POST request to create a new Skill.
-func (c *Client) CreateSkill(skill *Skill) (*Skill, error) {
-	// TODO: Replace "/skills" with the actual path to the "create skill" endpoint.
-	url := fmt.Sprintf("%s/skills", c.BaseURL)
+// RateLimit returns the rate-limit state reported by the most recent response,
+// parsed from the X-RateLimit-* headers. Every response replaces the stored
+// state, so the zero value means either that no response has been received
+// yet or that the most recent one carried no rate-limit headers.
+func (c *Client) RateLimit() RateLimit {
+	c.rateLimitMu.Lock()
+	defer c.rateLimitMu.Unlock()
+	return c.rateLimit
+}
 
-	body, err := json.Marshal(skill)
-	if err != nil {
-		return nil, err
+func (c *Client) setRateLimit(rl RateLimit) {
+	c.rateLimitMu.Lock()
+	defer c.rateLimitMu.Unlock()
+	c.rateLimit = rl
+}
+
+// doRequest builds and sends a single HTTP request to path, applying the client's
+// default headers and any per-request options, and returns the response, its
+// fully-read body, and the response's ETag header for the caller to decode.
+// Non-2xx responses are turned into an *APIError by the caller via newAPIError.
+// It never consults or populates c.Cache; see doCachedGET for that.
+func (c *Client) doRequest(ctx context.Context, method, path string, query url.Values, body io.Reader, opts ...RequestOption) (*http.Response, []byte, string, error) {
+	cfg := &requestConfig{
+		header: make(http.Header),
+		query:  make(url.Values),
+	}
+	for k, v := range query {
+		cfg.query[k] = v
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	reqURL := fmt.Sprintf("%s%s", c.BaseURL, path)
+	if len(cfg.query) > 0 {
+		reqURL = fmt.Sprintf("%s?%s", reqURL, cfg.query.Encode())
 	}
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(body))
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, body)
 	if err != nil {
-		return nil, err
+		return nil, nil, "", err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
-	req.Header.Set("x-api-key", c.ApiKey)
+	req.Header.Set("X-API-Key", c.ApiKey)
+	for k, values := range cfg.header {
+		for _, v := range values {
+			req.Header.Set(k, v)
+		}
+	}
+
+	httpClient := c.HttpClient
+	if cfg.timeout > 0 {
+		clientCopy := *c.HttpClient
+		clientCopy.Timeout = cfg.timeout
+		httpClient = &clientCopy
+	}
 
-	resp, err := c.HttpClient.Do(req)
+	resp, err := httpClient.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, nil, "", err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, errors.New(string(bodyBytes))
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp, nil, "", err
 	}
 
-	var newSkill Skill
-	err = json.NewDecoder(resp.Body).Decode(&newSkill)
+	return resp, bodyBytes, resp.Header.Get("ETag"), nil
+}
+
+// doCachedGET issues a GET through doRequest for the five ETag-aware list/get
+// endpoints (GetSkillByID, GetAllSkills, GetSkillsByCategory,
+// GetSkillsForProject, GetPopularSkills), transparently adding conditional
+// request support when c.Cache is set: an If-None-Match header is attached
+// from the cached entry for reqURL (unless the caller already set one via
+// WithHeader), and a resulting 304 is normalized back to 200 with the cached
+// body substituted in, so callers can treat it exactly like a fresh 200. A
+// fresh 200 response is stored in the cache for next time.
+func (c *Client) doCachedGET(ctx context.Context, path string, query url.Values, opts ...RequestOption) (*http.Response, []byte, string, error) {
+	if c.Cache == nil {
+		return c.doRequest(ctx, http.MethodGet, path, query, nil, opts...)
+	}
+
+	cfg := &requestConfig{header: make(http.Header), query: make(url.Values)}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	fullQuery := make(url.Values)
+	for k, v := range query {
+		fullQuery[k] = v
+	}
+	for k, v := range cfg.query {
+		fullQuery[k] = v
+	}
+	reqURL := fmt.Sprintf("%s%s", c.BaseURL, path)
+	if len(fullQuery) > 0 {
+		reqURL = fmt.Sprintf("%s?%s", reqURL, fullQuery.Encode())
+	}
+
+	getOpts := opts
+	if cfg.header.Get("If-None-Match") == "" {
+		if entry, ok := c.Cache.Get(reqURL); ok {
+			getOpts = append(append([]RequestOption{}, opts...), WithHeader("If-None-Match", entry.ETag))
+		}
+	}
+
+	resp, bodyBytes, etag, err := c.doRequest(ctx, http.MethodGet, path, query, nil, getOpts...)
 	if err != nil {
-		return nil, err
+		return resp, bodyBytes, etag, err
 	}
 
-	return &newSkill, nil
+	if resp.StatusCode == http.StatusNotModified {
+		if entry, ok := c.Cache.Get(reqURL); ok {
+			bodyBytes = entry.Body
+			if etag == "" {
+				etag = entry.ETag
+			}
+			resp.StatusCode = http.StatusOK
+			resp.Status = "200 OK"
+		}
+	} else if resp.StatusCode == http.StatusOK && etag != "" {
+		c.Cache.Set(reqURL, CacheEntry{ETag: etag, Body: bodyBytes})
+	}
+
+	return resp, bodyBytes, etag, nil
 }
 
-// GetSkillByID sends a GET request to retrieve a specific Skill by ID.
-func (c *Client) GetSkillByID(id uuid.UUID) (*Skill, error) {
-	url := fmt.Sprintf("%s/skills/%s", c.BaseURL, id)
+// CreateSkill sends a POST request to create a new Skill.
+func (c *Client) CreateSkill(ctx context.Context, skill *Skill, opts ...RequestOption) (*Skill, error) {
+	body, err := json.Marshal(skill)
+	if err != nil {
+		return nil, err
+	}
 
-	req, err := http.NewRequest("GET", url, nil)
+	resp, bodyBytes, _, err := c.doRequest(ctx, http.MethodPost, "/skills", nil, bytes.NewBuffer(body), opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
-	req.Header.Set("x-api-key", c.ApiKey)
+	if resp.StatusCode != http.StatusCreated {
+		return nil, newAPIError(resp, bodyBytes)
+	}
+
+	var newSkill Skill
+	if err := json.Unmarshal(bodyBytes, &newSkill); err != nil {
+		return nil, err
+	}
 
-	resp, err := c.HttpClient.Do(req)
+	return &newSkill, nil
+}
+
+// GetSkillByID sends a GET request to retrieve a specific Skill by ID, along
+// with the ETag the server returned for it.
+func (c *Client) GetSkillByID(ctx context.Context, id uuid.UUID, opts ...RequestOption) (*SkillWithETag, error) {
+	resp, bodyBytes, etag, err := c.doCachedGET(ctx, fmt.Sprintf("/skills/%s", id), nil, opts...)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, errors.New(string(bodyBytes))
+		return nil, newAPIError(resp, bodyBytes)
 	}
 
 	var skill Skill
-	err = json.NewDecoder(resp.Body).Decode(&skill)
-	if err != nil {
+	if err := json.Unmarshal(bodyBytes, &skill); err != nil {
 		return nil, err
 	}
 
-	return &skill, nil
+	return &SkillWithETag{Skill: skill, ETag: etag}, nil
 }
 
-// GetAllSkills sends a GET request to retrieve all skills
-func (c *Client) GetAllSkills() ([]Skill, error) {
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/skills", c.BaseURL), nil)
+// GetSkillByIDIfNoneMatch sends a conditional GET for a specific Skill, attaching
+// If-None-Match: etag. If the server answers 304 Not Modified, notModified is
+// true and skill is nil. Otherwise skill and its fresh ETag are returned.
+func (c *Client) GetSkillByIDIfNoneMatch(ctx context.Context, id uuid.UUID, etag string, opts ...RequestOption) (skill *Skill, newETag string, notModified bool, err error) {
+	opts = append([]RequestOption{WithHeader("If-None-Match", etag)}, opts...)
+
+	resp, bodyBytes, respETag, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/skills/%s", id), nil, nil, opts...)
 	if err != nil {
-		return nil, err
+		return nil, "", false, err
 	}
 
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
-	req.Header.Set("X-API-KEY", c.ApiKey)
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, respETag, true, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", false, newAPIError(resp, bodyBytes)
+	}
+
+	var s Skill
+	if err := json.Unmarshal(bodyBytes, &s); err != nil {
+		return nil, "", false, err
+	}
 
-	resp, err := c.HttpClient.Do(req)
+	return &s, respETag, false, nil
+}
+
+// GetAllSkills sends a GET request to retrieve all skills, along with the
+// ETag the server returned for the collection.
+func (c *Client) GetAllSkills(ctx context.Context, opts ...RequestOption) ([]Skill, string, error) {
+	resp, bodyBytes, etag, err := c.doCachedGET(ctx, "/skills", nil, opts...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", newAPIError(resp, bodyBytes)
+	}
+
+	var skills []Skill
+	if err := json.Unmarshal(bodyBytes, &skills); err != nil {
+		return nil, "", err
+	}
+
+	return skills, etag, nil
+}
+
+// ListOptions controls pagination, filtering, and sorting for list endpoints.
+// Zero values are omitted from the request, leaving the server's defaults in
+// effect.
+type ListOptions struct {
+	Page         int
+	PerPage      int
+	Sort         string
+	Order        string
+	CreatedSince time.Time
+	UpdatedSince time.Time
+}
+
+func (o ListOptions) toQuery() url.Values {
+	q := url.Values{}
+	if o.Page > 0 {
+		q.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.PerPage > 0 {
+		q.Set("per_page", strconv.Itoa(o.PerPage))
+	}
+	if o.Sort != "" {
+		q.Set("sort", o.Sort)
+	}
+	if o.Order != "" {
+		q.Set("order", o.Order)
+	}
+	if !o.CreatedSince.IsZero() {
+		q.Set("created_since", o.CreatedSince.Format(time.RFC3339))
+	}
+	if !o.UpdatedSince.IsZero() {
+		q.Set("updated_since", o.UpdatedSince.Format(time.RFC3339))
+	}
+	return q
+}
+
+// ListResponse wraps a page of skills with the pagination metadata parsed from
+// the server's Link and X-Total-Count headers (GitHub-style).
+type ListResponse struct {
+	Items      []Skill
+	NextPage   int
+	PrevPage   int
+	TotalCount int
+	ETag       string
+}
+
+// parseLinkHeader parses an RFC 5988 Link header into a map of rel -> URL.
+func parseLinkHeader(header string) map[string]string {
+	links := make(map[string]string)
+	if header == "" {
+		return links
+	}
+
+	for _, entry := range strings.Split(header, ",") {
+		parts := strings.Split(entry, ";")
+		if len(parts) < 2 {
+			continue
+		}
+
+		target := strings.Trim(strings.TrimSpace(parts[0]), "<>")
+		for _, param := range parts[1:] {
+			kv := strings.SplitN(strings.TrimSpace(param), "=", 2)
+			if len(kv) != 2 || strings.TrimSpace(kv[0]) != "rel" {
+				continue
+			}
+			links[strings.Trim(kv[1], "\"")] = target
+		}
+	}
+
+	return links
+}
+
+// pageFromURL extracts the "page" query parameter from a URL, returning 0 if
+// absent or unparsable.
+func pageFromURL(rawURL string) int {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return 0
+	}
+	page, _ := strconv.Atoi(parsed.Query().Get("page"))
+	return page
+}
+
+// GetAllSkillsPaged sends a GET request to retrieve one page of skills
+// according to opts, returning pagination metadata alongside the page's items.
+func (c *Client) GetAllSkillsPaged(ctx context.Context, opts ListOptions, reqOpts ...RequestOption) (*ListResponse, error) {
+	resp, bodyBytes, etag, err := c.doRequest(ctx, http.MethodGet, "/skills", opts.toQuery(), nil, reqOpts...)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, bodyBytes)
+	}
 
 	var skills []Skill
-	if err := json.NewDecoder(resp.Body).Decode(&skills); err != nil {
+	if err := json.Unmarshal(bodyBytes, &skills); err != nil {
 		return nil, err
 	}
 
-	return skills, nil
+	links := parseLinkHeader(resp.Header.Get("Link"))
+	totalCount, _ := strconv.Atoi(resp.Header.Get("X-Total-Count"))
+
+	listResp := &ListResponse{
+		Items:      skills,
+		TotalCount: totalCount,
+		ETag:       etag,
+	}
+	if next, ok := links["next"]; ok {
+		listResp.NextPage = pageFromURL(next)
+	}
+	if prev, ok := links["prev"]; ok {
+		listResp.PrevPage = pageFromURL(prev)
+	}
+
+	return listResp, nil
+}
+
+// EachSkill walks every page of skills matching opts, calling fn once per
+// skill, and stops at the first error fn or the server returns.
+func (c *Client) EachSkill(ctx context.Context, opts ListOptions, fn func(Skill) error) error {
+	page := opts.Page
+	if page <= 0 {
+		page = 1
+	}
+
+	for {
+		pageOpts := opts
+		pageOpts.Page = page
+
+		listResp, err := c.GetAllSkillsPaged(ctx, pageOpts)
+		if err != nil {
+			return err
+		}
+
+		for _, skill := range listResp.Items {
+			if err := fn(skill); err != nil {
+				return err
+			}
+		}
+
+		if listResp.NextPage == 0 {
+			return nil
+		}
+		page = listResp.NextPage
+	}
 }
 
 // UpdateSkill sends a PATCH request to update a specific skill
-func (c *Client) UpdateSkill(id uuid.UUID, updatedSkill Skill) (Skill, error) {
+func (c *Client) UpdateSkill(ctx context.Context, id uuid.UUID, updatedSkill Skill, opts ...RequestOption) (Skill, error) {
 	updatedSkill.ID = id // Ensure the ID is set correctly
 	payload, err := json.Marshal(updatedSkill)
 	if err != nil {
 		return Skill{}, err
 	}
 
-	req, err := http.NewRequest(http.MethodPatch, fmt.Sprintf("%s/skills/%s", c.BaseURL, id), bytes.NewBuffer(payload))
+	resp, bodyBytes, _, err := c.doRequest(ctx, http.MethodPatch, fmt.Sprintf("/skills/%s", id), nil, bytes.NewBuffer(payload), opts...)
 	if err != nil {
 		return Skill{}, err
 	}
 
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
-	req.Header.Set("X-API-KEY", c.ApiKey)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.HttpClient.Do(req)
-	if err != nil {
-		return Skill{}, err
+	if resp.StatusCode != http.StatusOK {
+		return Skill{}, newAPIError(resp, bodyBytes)
 	}
-	defer resp.Body.Close()
 
 	var skill Skill
-	if err := json.NewDecoder(resp.Body).Decode(&skill); err != nil {
+	if err := json.Unmarshal(bodyBytes, &skill); err != nil {
 		return Skill{}, err
 	}
 
@@ -183,250 +605,180 @@ func (c *Client) UpdateSkill(id uuid.UUID, updatedSkill Skill) (Skill, error) {
 }
 
 // DeleteSkill deletes a skill by ID.
-func (c *Client) DeleteSkill(skillID uuid.UUID) error {
-	req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/skills/%s", c.BaseURL, skillID), nil)
-	if err != nil {
-		return err
-	}
-
-	req.Header.Add("Authorization", "Bearer "+c.Token)
-	req.Header.Add("X-API-KEY", c.ApiKey)
-
-	resp, err := c.HttpClient.Do(req)
+func (c *Client) DeleteSkill(ctx context.Context, skillID uuid.UUID, opts ...RequestOption) error {
+	resp, bodyBytes, _, err := c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/skills/%s", skillID), nil, nil, opts...)
 	if err != nil {
 		return err
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("failed to delete skill: status code %d", resp.StatusCode)
+		return newAPIError(resp, bodyBytes)
 	}
 
 	return nil
 }
 
-// SearchSkills searches for skills by a query.
-func (c *Client) SearchSkills(query string) ([]Skill, error) {
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/skills/search/%s", c.BaseURL, query), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Authorization", "Bearer "+c.Token)
-	req.Header.Add("X-API-KEY", c.ApiKey)
+// SearchSkills searches for skills matching q, passed as a proper query
+// parameter so spaces and other special characters are handled correctly.
+func (c *Client) SearchSkills(ctx context.Context, q string, opts ...RequestOption) ([]Skill, error) {
+	query := url.Values{}
+	query.Set("q", q)
 
-	resp, err := c.HttpClient.Do(req)
+	resp, bodyBytes, _, err := c.doRequest(ctx, http.MethodGet, "/skills/search", query, nil, opts...)
 	if err != nil {
 		return nil, err
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to search skills: status code %d", resp.StatusCode)
+		return nil, newAPIError(resp, bodyBytes)
 	}
 
 	var skills []Skill
-	err = json.NewDecoder(resp.Body).Decode(&skills)
-	if err != nil {
+	if err := json.Unmarshal(bodyBytes, &skills); err != nil {
 		return nil, err
 	}
 
 	return skills, nil
 }
 
-// GetSkillsByCategory retrieves skills by a specific category.
-func (c *Client) GetSkillsByCategory(categoryID uuid.UUID) ([]Skill, error) {
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/skills/category/%s", c.BaseURL, categoryID), nil)
+// GetSkillsByCategory retrieves skills by a specific category, along with the
+// ETag the server returned for the collection.
+func (c *Client) GetSkillsByCategory(ctx context.Context, categoryID uuid.UUID, opts ...RequestOption) ([]Skill, string, error) {
+	resp, bodyBytes, etag, err := c.doCachedGET(ctx, fmt.Sprintf("/skills/category/%s", categoryID), nil, opts...)
 	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Authorization", "Bearer "+c.Token)
-	req.Header.Add("X-API-KEY", c.ApiKey)
-
-	resp, err := c.HttpClient.Do(req)
-	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to get skills by category: status code %d", resp.StatusCode)
+		return nil, "", newAPIError(resp, bodyBytes)
 	}
 
 	var skills []Skill
-	err = json.NewDecoder(resp.Body).Decode(&skills)
-	if err != nil {
-		return nil, err
+	if err := json.Unmarshal(bodyBytes, &skills); err != nil {
+		return nil, "", err
 	}
 
-	return skills, nil
+	return skills, etag, nil
 }
 
-func (c *Client) GetSkillsByUserID(userID string) ([]Skill, error) {
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/skills/user/%s", c.BaseURL, userID), nil)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("Authorization", "Bearer "+c.Token)
-	req.Header.Set("X-API-Key", c.ApiKey)
-
-	resp, err := c.HttpClient.Do(req)
+func (c *Client) GetSkillsByUserID(ctx context.Context, userID string, opts ...RequestOption) ([]Skill, error) {
+	resp, bodyBytes, _, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/skills/user/%s", userID), nil, nil, opts...)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("error: received status code %d", resp.StatusCode)
+		return nil, newAPIError(resp, bodyBytes)
 	}
 
 	var skills []Skill
-	err = json.NewDecoder(resp.Body).Decode(&skills)
-	if err != nil {
+	if err := json.Unmarshal(bodyBytes, &skills); err != nil {
 		return nil, err
 	}
 
 	return skills, nil
 }
 
-func (c *Client) GetPopularSkills(limit int) ([]Skill, error) {
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/skills/popular?limit=%s", c.BaseURL, strconv.Itoa(limit)), nil)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("Authorization", "Bearer "+c.Token)
-	req.Header.Set("X-API-Key", c.ApiKey)
+// GetPopularSkills retrieves the most popular skills, along with the ETag the
+// server returned for the collection.
+func (c *Client) GetPopularSkills(ctx context.Context, limit int, opts ...RequestOption) ([]Skill, string, error) {
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(limit))
 
-	resp, err := c.HttpClient.Do(req)
+	resp, bodyBytes, etag, err := c.doCachedGET(ctx, "/skills/popular", query, opts...)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("error: received status code %d", resp.StatusCode)
+		return nil, "", newAPIError(resp, bodyBytes)
 	}
 
 	var skills []Skill
-	err = json.NewDecoder(resp.Body).Decode(&skills)
-	if err != nil {
-		return nil, err
+	if err := json.Unmarshal(bodyBytes, &skills); err != nil {
+		return nil, "", err
 	}
 
-	return skills, nil
+	return skills, etag, nil
 }
 
-func (c *Client) AssociateSkillWithProject(sp SkillProject) error {
+func (c *Client) AssociateSkillWithProject(ctx context.Context, sp SkillProject, opts ...RequestOption) error {
 	body, err := json.Marshal(sp)
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/associate_skill", c.BaseURL), bytes.NewBuffer(body))
-	if err != nil {
-		return err
-	}
-
-	req.Header.Add("Content-Type", "application/json")
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.Token))
-	req.Header.Add("X-Api-Key", c.ApiKey)
-
-	resp, err := c.HttpClient.Do(req)
+	resp, bodyBytes, _, err := c.doRequest(ctx, http.MethodPost, "/associate_skill", nil, bytes.NewBuffer(body), opts...)
 	if err != nil {
 		return err
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return errors.New(resp.Status)
+		return newAPIError(resp, bodyBytes)
 	}
 
 	return nil
 }
 
-func (c *Client) DisassociateSkillFromProject(sp SkillProject) error {
+func (c *Client) DisassociateSkillFromProject(ctx context.Context, sp SkillProject, opts ...RequestOption) error {
 	body, err := json.Marshal(sp)
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/disassociate_skill", c.BaseURL), bytes.NewBuffer(body))
-	if err != nil {
-		return err
-	}
-
-	req.Header.Add("Content-Type", "application/json")
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.Token))
-	req.Header.Add("X-Api-Key", c.ApiKey)
-
-	resp, err := c.HttpClient.Do(req)
+	resp, bodyBytes, _, err := c.doRequest(ctx, http.MethodPost, "/disassociate_skill", nil, bytes.NewBuffer(body), opts...)
 	if err != nil {
 		return err
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return errors.New(resp.Status)
+		return newAPIError(resp, bodyBytes)
 	}
 
 	return nil
 }
 
-func (c *Client) GetProjectIDsForSkill(skillID uuid.UUID) ([]uuid.UUID, error) {
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/get_projects", c.BaseURL), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	q := url.Values{}
-	q.Add("skill_id", skillID.String())
-	req.URL.RawQuery = q.Encode()
-
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.Token))
-	req.Header.Add("X-Api-Key", c.ApiKey)
+func (c *Client) GetProjectIDsForSkill(ctx context.Context, skillID uuid.UUID, opts ...RequestOption) ([]uuid.UUID, error) {
+	query := url.Values{}
+	query.Set("skill_id", skillID.String())
 
-	resp, err := c.HttpClient.Do(req)
+	resp, bodyBytes, _, err := c.doRequest(ctx, http.MethodGet, "/get_projects", query, nil, opts...)
 	if err != nil {
 		return nil, err
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, errors.New(resp.Status)
+		return nil, newAPIError(resp, bodyBytes)
 	}
 
 	var projectIDs []uuid.UUID
-	err = json.NewDecoder(resp.Body).Decode(&projectIDs)
-	if err != nil {
+	if err := json.Unmarshal(bodyBytes, &projectIDs); err != nil {
 		return nil, err
 	}
 
 	return projectIDs, nil
 }
 
-// GetSkillsForProject sends a GET request to the server to get the skills for a particular project.
-func (c *Client) GetSkillsForProject(projectID uuid.UUID) ([]Skill, error) {
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/get_skills_for_project", c.BaseURL), nil)
-	if err != nil {
-		return nil, err
-	}
+// GetSkillsForProject sends a GET request to the server to get the skills for
+// a particular project, along with the ETag the server returned for the
+// collection.
+func (c *Client) GetSkillsForProject(ctx context.Context, projectID uuid.UUID, opts ...RequestOption) ([]Skill, string, error) {
+	query := url.Values{}
+	query.Set("project_id", projectID.String())
 
-	q := url.Values{}
-	q.Add("project_id", projectID.String())
-	req.URL.RawQuery = q.Encode()
-
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.Token))
-	req.Header.Add("X-Api-Key", c.ApiKey)
-
-	resp, err := c.HttpClient.Do(req)
+	resp, bodyBytes, etag, err := c.doCachedGET(ctx, "/get_skills_for_project", query, opts...)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, errors.New(resp.Status)
+		return nil, "", newAPIError(resp, bodyBytes)
 	}
 
 	var skills []Skill
-	err = json.NewDecoder(resp.Body).Decode(&skills)
-	if err != nil {
-		return nil, err
+	if err := json.Unmarshal(bodyBytes, &skills); err != nil {
+		return nil, "", err
 	}
 
-	return skills, nil
+	return skills, etag, nil
 }
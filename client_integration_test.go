@@ -0,0 +1,39 @@
+package skillsclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// TestGetSkillByID_ErrorBodyIsPreserved guards against doRequest draining and
+// closing resp.Body before newAPIError gets a chance to parse it.
+func TestGetSkillByID_ErrorBodyIsPreserved(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"message":"bad input","code":"E1"}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "tok", "key")
+	c.Cache = nil
+
+	_, err := c.GetSkillByID(context.Background(), uuid.New())
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("expected *APIError, got %T: %v", err, err)
+	}
+	if apiErr.Message != "bad input" {
+		t.Errorf("Message = %q, want %q", apiErr.Message, "bad input")
+	}
+	if apiErr.Code != "E1" {
+		t.Errorf("Code = %q, want %q", apiErr.Code, "E1")
+	}
+}
@@ -0,0 +1,75 @@
+package skillsclient
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestNewAPIError(t *testing.T) {
+	tests := []struct {
+		name        string
+		statusCode  int
+		requestID   string
+		body        string
+		wantMessage string
+		wantCode    string
+	}{
+		{
+			name:        "parses message and code from JSON body",
+			statusCode:  http.StatusBadRequest,
+			body:        `{"message":"bad input","code":"E1"}`,
+			wantMessage: "bad input",
+			wantCode:    "E1",
+		},
+		{
+			name:        "falls back to raw body when not JSON",
+			statusCode:  http.StatusInternalServerError,
+			body:        "boom",
+			wantMessage: "boom",
+		},
+		{
+			name:        "falls back to status text when body is empty",
+			statusCode:  http.StatusNotFound,
+			body:        "",
+			wantMessage: "404 Not Found",
+		},
+		{
+			name:        "carries the request id through",
+			statusCode:  http.StatusBadRequest,
+			requestID:   "req-123",
+			body:        `{"message":"bad input","code":"E1"}`,
+			wantMessage: "bad input",
+			wantCode:    "E1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			if tt.requestID != "" {
+				header.Set("X-Request-ID", tt.requestID)
+			}
+			resp := &http.Response{
+				StatusCode: tt.statusCode,
+				Status:     fmt.Sprintf("%d %s", tt.statusCode, http.StatusText(tt.statusCode)),
+				Header:     header,
+			}
+
+			err := newAPIError(resp, []byte(tt.body))
+
+			if err.StatusCode != tt.statusCode {
+				t.Errorf("StatusCode = %d, want %d", err.StatusCode, tt.statusCode)
+			}
+			if err.Message != tt.wantMessage {
+				t.Errorf("Message = %q, want %q", err.Message, tt.wantMessage)
+			}
+			if err.Code != tt.wantCode {
+				t.Errorf("Code = %q, want %q", err.Code, tt.wantCode)
+			}
+			if err.RequestID != tt.requestID {
+				t.Errorf("RequestID = %q, want %q", err.RequestID, tt.requestID)
+			}
+		})
+	}
+}
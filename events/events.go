@@ -0,0 +1,32 @@
+// Package events defines the event types delivered by Client.Subscribe for
+// skill lifecycle changes.
+package events
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Type identifies what happened to a skill.
+type Type string
+
+const (
+	SkillCreated       Type = "skill.created"
+	SkillUpdated       Type = "skill.updated"
+	SkillDeleted       Type = "skill.deleted"
+	SkillAssociated    Type = "skill.associated"
+	SkillDisassociated Type = "skill.disassociated"
+)
+
+// Event is a single skill lifecycle change delivered over the subscription
+// stream. Payload holds the type-specific body (e.g. the full Skill for
+// skill.created/skill.updated, or skill_id/project_id for association
+// changes); callers decode it with json.Unmarshal once they've checked Type.
+type Event struct {
+	Type      Type            `json:"type"`
+	Timestamp time.Time       `json:"timestamp"`
+	SkillID   uuid.UUID       `json:"skill_id"`
+	Payload   json.RawMessage `json:"payload"`
+}
@@ -0,0 +1,183 @@
+package skillsclient
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/PiccoloMondoC/skillsclient/events"
+)
+
+// Subscribe opens a long-lived SSE stream to /skills/events and returns a
+// channel of skill lifecycle events matching topics (skill.created,
+// skill.updated, skill.deleted, skill.associated, skill.disassociated; an
+// empty slice subscribes to all topics). The connection is established
+// synchronously so callers get an error immediately if it fails; after that,
+// drops are reconnected automatically using Last-Event-ID so no events are
+// missed, with backoff between attempts. The returned channel is closed when
+// ctx is canceled.
+func (c *Client) Subscribe(ctx context.Context, topics []string, opts ...RequestOption) (<-chan events.Event, error) {
+	query := url.Values{}
+	if len(topics) > 0 {
+		query.Set("topics", strings.Join(topics, ","))
+	}
+
+	resp, err := c.connectEventStream(ctx, query, "", opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan events.Event)
+	go c.streamEvents(ctx, resp, query, ch, opts...)
+	return ch, nil
+}
+
+func (c *Client) connectEventStream(ctx context.Context, query url.Values, lastEventID string, opts ...RequestOption) (*http.Response, error) {
+	reqOpts := append([]RequestOption{WithHeader("Accept", "text/event-stream")}, opts...)
+	if lastEventID != "" {
+		reqOpts = append(reqOpts, WithHeader("Last-Event-ID", lastEventID))
+	}
+
+	cfg := &requestConfig{header: make(http.Header), query: make(url.Values)}
+	for k, v := range query {
+		cfg.query[k] = v
+	}
+	for _, opt := range reqOpts {
+		opt(cfg)
+	}
+
+	reqURL := fmt.Sprintf("%s/skills/events", c.BaseURL)
+	if len(cfg.query) > 0 {
+		reqURL = fmt.Sprintf("%s?%s", reqURL, cfg.query.Encode())
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
+	req.Header.Set("X-API-Key", c.ApiKey)
+	for k, values := range cfg.header {
+		for _, v := range values {
+			req.Header.Set(k, v)
+		}
+	}
+
+	httpClient := c.HttpClient
+	if cfg.timeout > 0 {
+		clientCopy := *c.HttpClient
+		clientCopy.Timeout = cfg.timeout
+		httpClient = &clientCopy
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		return nil, newAPIError(resp, bodyBytes)
+	}
+
+	return resp, nil
+}
+
+// streamEvents reads resp and forwards decoded events to ch, reconnecting
+// with backoff (carrying Last-Event-ID forward) whenever the stream drops,
+// until ctx is canceled.
+func (c *Client) streamEvents(ctx context.Context, resp *http.Response, query url.Values, ch chan<- events.Event, opts ...RequestOption) {
+	defer close(ch)
+
+	lastEventID := ""
+	backoff := defaultInitialBackoff
+
+	for {
+		lastEventID = readSSE(ctx, resp.Body, ch, lastEventID)
+		resp.Body.Close()
+
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		backoff *= 2
+		if backoff > defaultMaxBackoff {
+			backoff = defaultMaxBackoff
+		}
+
+		next, err := c.connectEventStream(ctx, query, lastEventID, opts...)
+		if err != nil {
+			continue
+		}
+		resp = next
+		backoff = defaultInitialBackoff
+	}
+}
+
+// readSSE decodes a text/event-stream body, sending each "data:" payload as
+// an events.Event on ch, and returns the last "id:" seen for reconnection.
+func readSSE(ctx context.Context, body io.Reader, ch chan<- events.Event, lastEventID string) string {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var eventType, data string
+	flush := func() {
+		if data == "" {
+			return
+		}
+		var ev events.Event
+		if err := json.Unmarshal([]byte(data), &ev); err == nil {
+			if eventType != "" {
+				ev.Type = events.Type(eventType)
+			}
+			select {
+			case ch <- ev:
+			case <-ctx.Done():
+			}
+		}
+		eventType, data = "", ""
+	}
+
+	for scanner.Scan() {
+		select {
+		case <-ctx.Done():
+			return lastEventID
+		default:
+		}
+
+		line := scanner.Text()
+		switch {
+		case line == "":
+			flush()
+		case strings.HasPrefix(line, "id:"):
+			lastEventID = strings.TrimSpace(strings.TrimPrefix(line, "id:"))
+		case strings.HasPrefix(line, "event:"):
+			eventType = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			chunk := strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " ")
+			if data != "" {
+				data += "\n"
+			}
+			data += chunk
+		}
+	}
+	flush()
+
+	return lastEventID
+}
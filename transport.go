@@ -0,0 +1,178 @@
+package skillsclient
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultMaxRetries       = 3
+	defaultBreakerThreshold = 5
+	defaultBreakerCooldown  = 30 * time.Second
+	defaultInitialBackoff   = 200 * time.Millisecond
+	defaultMaxBackoff       = 5 * time.Second
+)
+
+// RateLimit is the rate-limit state reported by the server on a response, via
+// the X-RateLimit-Limit, X-RateLimit-Remaining, and X-RateLimit-Reset headers.
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+func parseRateLimit(resp *http.Response) RateLimit {
+	limit, _ := strconv.Atoi(resp.Header.Get("X-RateLimit-Limit"))
+	remaining, _ := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+
+	var reset time.Time
+	if unix, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil && unix > 0 {
+		reset = time.Unix(unix, 0)
+	}
+
+	return RateLimit{Limit: limit, Remaining: remaining, Reset: reset}
+}
+
+// circuitBreaker trips after a run of consecutive failures and refuses
+// further requests until its cooldown elapses.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	openUntil time.Time
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+	}
+}
+
+func (b *circuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.openUntil.IsZero() || time.Now().After(b.openUntil)
+}
+
+func (b *circuitBreaker) recordSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures = 0
+	b.openUntil = time.Time{}
+}
+
+func (b *circuitBreaker) recordFailure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openUntil = time.Now().Add(b.cooldown)
+	}
+}
+
+// isIdempotentRequest reports whether req is safe to retry: GET/HEAD/DELETE/
+// PATCH are always retried, POST only when the caller supplied an Idempotency-Key
+// header (e.g. on CreateSkill or AssociateSkillWithProject).
+func isIdempotentRequest(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodDelete, http.MethodPatch, http.MethodHead:
+		return true
+	case http.MethodPost:
+		return req.Header.Get("Idempotency-Key") != ""
+	default:
+		return false
+	}
+}
+
+// retryDelay picks how long to wait before the next attempt, preferring the
+// server's own guidance (Retry-After, then X-RateLimit-Reset) over backoff.
+func retryDelay(resp *http.Response, attempt int) time.Duration {
+	if resp != nil {
+		if ra := resp.Header.Get("Retry-After"); ra != "" {
+			if secs, err := strconv.Atoi(ra); err == nil {
+				return time.Duration(secs) * time.Second
+			}
+			if when, err := http.ParseTime(ra); err == nil {
+				if d := time.Until(when); d > 0 {
+					return d
+				}
+			}
+		}
+		if reset := resp.Header.Get("X-RateLimit-Reset"); reset != "" {
+			if unix, err := strconv.ParseInt(reset, 10, 64); err == nil {
+				if d := time.Until(time.Unix(unix, 0)); d > 0 {
+					return d
+				}
+			}
+		}
+	}
+
+	backoff := defaultInitialBackoff * time.Duration(1<<uint(attempt))
+	if backoff > defaultMaxBackoff {
+		backoff = defaultMaxBackoff
+	}
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
+
+// retryTransport wraps an http.RoundTripper with exponential backoff on
+// 5xx/429 responses, a circuit breaker, and rate-limit tracking on the owning
+// Client. Only idempotent requests are retried.
+type retryTransport struct {
+	next    http.RoundTripper
+	client  *Client
+	retries int
+	breaker *circuitBreaker
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.breaker.allow() {
+		return nil, fmt.Errorf("skillsclient: circuit breaker open, refusing request to %s", req.URL.Path)
+	}
+
+	idempotent := isIdempotentRequest(req)
+
+	var resp *http.Response
+	var err error
+	for attempt := 0; ; attempt++ {
+		resp, err = t.next.RoundTrip(req)
+		if err == nil {
+			t.client.setRateLimit(parseRateLimit(resp))
+		}
+
+		retryable := err != nil || resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+		if !retryable {
+			t.breaker.recordSuccess()
+			return resp, err
+		}
+		if !idempotent || attempt >= t.retries {
+			t.breaker.recordFailure()
+			return resp, err
+		}
+
+		wait := retryDelay(resp, attempt)
+		if resp != nil {
+			resp.Body.Close()
+		}
+		if req.GetBody != nil {
+			body, gbErr := req.GetBody()
+			if gbErr != nil {
+				return resp, err
+			}
+			req.Body = body
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package skillsclient
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestIsIdempotentRequest(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		idempotencyKey string
+		want           bool
+	}{
+		{name: "GET is retryable", method: http.MethodGet, want: true},
+		{name: "DELETE is retryable", method: http.MethodDelete, want: true},
+		{name: "PATCH is retryable", method: http.MethodPatch, want: true},
+		{name: "HEAD is retryable", method: http.MethodHead, want: true},
+		{name: "POST without Idempotency-Key is not retryable", method: http.MethodPost, want: false},
+		{name: "POST with Idempotency-Key is retryable", method: http.MethodPost, idempotencyKey: "abc-123", want: true},
+		{name: "PUT is not retryable", method: http.MethodPut, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(tt.method, "http://example.com/skills", nil)
+			if err != nil {
+				t.Fatalf("NewRequest: %v", err)
+			}
+			if tt.idempotencyKey != "" {
+				req.Header.Set("Idempotency-Key", tt.idempotencyKey)
+			}
+
+			if got := isIdempotentRequest(req); got != tt.want {
+				t.Errorf("isIdempotentRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryDelay_RetryAfterSeconds(t *testing.T) {
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("Retry-After", "2")
+
+	got := retryDelay(resp, 0)
+	if got != 2*time.Second {
+		t.Errorf("retryDelay() = %v, want %v", got, 2*time.Second)
+	}
+}
+
+func TestRetryDelay_RetryAfterHTTPDate(t *testing.T) {
+	when := time.Now().Add(3 * time.Second)
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("Retry-After", when.UTC().Format(http.TimeFormat))
+
+	got := retryDelay(resp, 0)
+	if got <= 0 || got > 3*time.Second {
+		t.Errorf("retryDelay() = %v, want something in (0, 3s]", got)
+	}
+}
+
+func TestRetryDelay_RateLimitResetFallback(t *testing.T) {
+	reset := time.Now().Add(4 * time.Second)
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+
+	got := retryDelay(resp, 0)
+	if got <= 0 || got > 4*time.Second {
+		t.Errorf("retryDelay() = %v, want something in (0, 4s]", got)
+	}
+}
+
+func TestRetryDelay_ExponentialBackoffWhenNoHeaders(t *testing.T) {
+	resp := &http.Response{Header: http.Header{}}
+
+	for attempt := 0; attempt < 4; attempt++ {
+		got := retryDelay(resp, attempt)
+		maxBackoff := defaultInitialBackoff * time.Duration(1<<uint(attempt))
+		if maxBackoff > defaultMaxBackoff {
+			maxBackoff = defaultMaxBackoff
+		}
+		if got < 0 || got > maxBackoff {
+			t.Errorf("attempt %d: retryDelay() = %v, want in [0, %v]", attempt, got, maxBackoff)
+		}
+	}
+}
+
+func TestRetryDelay_NilResponseStillBacksOff(t *testing.T) {
+	got := retryDelay(nil, 0)
+	if got < 0 || got > defaultInitialBackoff {
+		t.Errorf("retryDelay(nil, 0) = %v, want in [0, %v]", got, defaultInitialBackoff)
+	}
+}
+
+func TestCircuitBreaker_TripsAfterThreshold(t *testing.T) {
+	b := newCircuitBreaker(3, 50*time.Millisecond)
+
+	for i := 0; i < 2; i++ {
+		b.recordFailure()
+		if !b.allow() {
+			t.Fatalf("breaker should still allow requests after %d failures", i+1)
+		}
+	}
+
+	b.recordFailure()
+	if b.allow() {
+		t.Fatal("breaker should be open after reaching its failure threshold")
+	}
+}
+
+func TestCircuitBreaker_ClosesAfterCooldown(t *testing.T) {
+	b := newCircuitBreaker(1, 20*time.Millisecond)
+
+	b.recordFailure()
+	if b.allow() {
+		t.Fatal("breaker should be open immediately after tripping")
+	}
+
+	time.Sleep(30 * time.Millisecond)
+	if !b.allow() {
+		t.Fatal("breaker should allow requests again once the cooldown elapses")
+	}
+}
+
+func TestCircuitBreaker_SuccessResetsFailures(t *testing.T) {
+	b := newCircuitBreaker(2, time.Second)
+
+	b.recordFailure()
+	b.recordSuccess()
+	b.recordFailure()
+	if !b.allow() {
+		t.Fatal("a single failure after a reset should not trip the breaker")
+	}
+}